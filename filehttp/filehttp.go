@@ -0,0 +1,233 @@
+// Package filehttp turns a localinterface.File tree into a static-file
+// server: directory paths render an HTML index, regular files stream
+// their contents with a sniffed Content-Type and, where possible, Range
+// support.
+package filehttp
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	pathpkg "path"
+	"sort"
+	"strings"
+	"time"
+
+	localinterface "github.com/alikatgh/CargoForge-C"
+)
+
+// Opener is implemented by File values that can resolve a path to a
+// nested File within a directory tree. The minimal localinterface.File
+// interface has no such accessor, so Handler type-asserts to Opener to
+// walk into subdirectories named in the request path; a root that
+// doesn't implement it is served only at "/", and every other path gets
+// the 404 page.
+type Opener interface {
+	Open(name string) (localinterface.File, error)
+}
+
+// Handler serves root as a static-file tree: directories get a
+// breadcrumbed, sortable HTML index, and regular files are streamed with
+// a sniffed Content-Type and Range support when root (or the resolved
+// entry) implements io.Seeker.
+func Handler(root localinterface.File) http.Handler {
+	return &handler{root: root}
+}
+
+type handler struct {
+	root localinterface.File
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reqPath := pathpkg.Clean("/" + r.URL.Path)
+
+	target, err := h.resolve(reqPath)
+	if err != nil {
+		h.notFound(w, reqPath)
+		return
+	}
+
+	info, err := target.Stat()
+	if err != nil {
+		h.notFound(w, reqPath)
+		return
+	}
+
+	if info.IsDir() {
+		h.serveDir(w, reqPath, target, r.URL.Query().Get("sort"))
+		return
+	}
+	h.serveFile(w, r, info, target)
+}
+
+func (h *handler) resolve(reqPath string) (localinterface.File, error) {
+	if reqPath == "/" || reqPath == "." {
+		return h.root, nil
+	}
+	opener, ok := h.root.(Opener)
+	if !ok {
+		return nil, fmt.Errorf("filehttp: %q: %w", reqPath, localinterface.ErrNotFound)
+	}
+	return opener.Open(strings.TrimPrefix(reqPath, "/"))
+}
+
+// serveFile streams a regular file's contents, sniffing Content-Type
+// from the first 512 bytes. If f implements io.Seeker, http.ServeContent
+// is used so Range and conditional requests are honored for free;
+// otherwise the sniffed prefix plus the rest of the stream is copied
+// without Range support.
+func (h *handler) serveFile(w http.ResponseWriter, r *http.Request, info os.FileInfo, f localinterface.File) {
+	var sniff [512]byte
+	n, _ := io.ReadFull(f, sniff[:])
+	w.Header().Set("Content-Type", http.DetectContentType(sniff[:n]))
+
+	if seeker, ok := f.(io.Seeker); ok {
+		if _, err := seeker.Seek(0, io.SeekStart); err == nil {
+			http.ServeContent(w, r, info.Name(), info.ModTime(), struct {
+				io.Reader
+				io.Seeker
+			}{f, seeker})
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+	w.Write(sniff[:n])
+	io.Copy(w, f)
+}
+
+type entry struct {
+	Name    string
+	Href    string
+	Size    int64
+	ModTime string
+	IsDir   bool
+
+	modTime time.Time // unformatted, used for mtime sorting
+}
+
+func (h *handler) serveDir(w http.ResponseWriter, reqPath string, dir localinterface.File, sortBy string) {
+	infos, err := dir.Readdir(-1)
+	if err != nil {
+		http.Error(w, "failed to list directory", http.StatusInternalServerError)
+		return
+	}
+
+	entries := make([]entry, 0, len(infos))
+	for _, info := range infos {
+		entries = append(entries, entry{
+			Name:    info.Name(),
+			Href:    childHref(reqPath, info.Name(), info.IsDir()),
+			Size:    info.Size(),
+			ModTime: info.ModTime().Format("2006-01-02 15:04:05"),
+			IsDir:   info.IsDir(),
+			modTime: info.ModTime(),
+		})
+	}
+	if sortBy == "" {
+		sortBy = "name"
+	}
+	sortEntries(entries, sortBy)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	indexTemplate.Execute(w, indexData{
+		Breadcrumbs: breadcrumbs(reqPath),
+		Path:        reqPath,
+		Entries:     entries,
+		SortBy:      sortBy,
+	})
+}
+
+// childHref builds the URL an entry named name under dir should link to,
+// the way http.FileServer's dirList does: the segment is escaped on its
+// own (so a literal "/" in the name can't be mistaken for a path
+// separator) and directories get a trailing slash.
+func childHref(dir, name string, isDir bool) string {
+	href := url.PathEscape(name)
+	if isDir {
+		href += "/"
+	}
+	if !strings.HasSuffix(dir, "/") {
+		dir += "/"
+	}
+	return dir + href
+}
+
+func sortEntries(entries []entry, by string) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].IsDir != entries[j].IsDir {
+			return entries[i].IsDir
+		}
+		switch by {
+		case "size":
+			return entries[i].Size < entries[j].Size
+		case "mtime":
+			return entries[i].modTime.Before(entries[j].modTime)
+		default:
+			return entries[i].Name < entries[j].Name
+		}
+	})
+}
+
+type breadcrumb struct {
+	Name string
+	Path string
+}
+
+func breadcrumbs(reqPath string) []breadcrumb {
+	crumbs := []breadcrumb{{Name: "/", Path: "/"}}
+	parts := strings.Split(strings.Trim(reqPath, "/"), "/")
+	built := ""
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		built += "/" + part
+		crumbs = append(crumbs, breadcrumb{Name: part, Path: built})
+	}
+	return crumbs
+}
+
+func (h *handler) notFound(w http.ResponseWriter, reqPath string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusNotFound)
+	notFoundTemplate.Execute(w, reqPath)
+}
+
+type indexData struct {
+	Breadcrumbs []breadcrumb
+	Path        string
+	Entries     []entry
+	SortBy      string
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Index of {{.Path}}</title></head>
+<body>
+<h1>
+{{range .Breadcrumbs}}<a href="{{.Path}}">{{.Name}}</a> / {{end}}
+</h1>
+<table>
+<tr>
+<th>{{if eq .SortBy "name"}}<strong>Name</strong>{{else}}<a href="?sort=name">Name</a>{{end}}</th>
+<th>{{if eq .SortBy "size"}}<strong>Size</strong>{{else}}<a href="?sort=size">Size</a>{{end}}</th>
+<th>{{if eq .SortBy "mtime"}}<strong>Last Modified</strong>{{else}}<a href="?sort=mtime">Last Modified</a>{{end}}</th>
+</tr>
+{{range .Entries}}<tr><td><a href="{{.Href}}">{{if .IsDir}}{{.Name}}/{{else}}{{.Name}}{{end}}</a></td><td>{{.Size}}</td><td>{{.ModTime}}</td></tr>
+{{end}}
+</table>
+</body>
+</html>`))
+
+var notFoundTemplate = template.Must(template.New("404").Parse(`<!DOCTYPE html>
+<html>
+<head><title>404 Not Found</title></head>
+<body>
+<h1>404 Not Found</h1>
+<p>No such file or directory: {{.}}</p>
+</body>
+</html>`))
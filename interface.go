@@ -6,46 +6,95 @@ import (
 	"os"
 )
 
-type error interface {
-	Error() string
+// Coded is implemented by errors that carry an HTTP-style status code, so
+// callers can branch on the code programmatically instead of matching on
+// the formatted message string.
+type Coded interface {
+	Code() int
 }
 
-type networkProblem struct {
+// baseProblem holds the fields shared by every problem type below: a
+// code, a message, and an optional wrapped cause. Embedding it gives each
+// sibling type Code() and Unwrap() for free, so they compose with
+// errors.Is/errors.As without each having to repeat the plumbing.
+type baseProblem struct {
 	message string
 	code    int
+	cause   error
+}
+
+func (b baseProblem) Code() int { return b.code }
+
+func (b baseProblem) Unwrap() error { return b.cause }
+
+type networkProblem struct {
+	baseProblem
 }
 
 func (np networkProblem) Error() string {
 	return fmt.Sprintf("network error! message: %s, code: %v", np.message, np.code)
 }
 
-func handleErr(err error) {
-	fmt.Println(err.Error())
+type authProblem struct {
+	baseProblem
 }
 
-np := networkProblem{
-	message: "we received a problem",
-	code:    404,
+func (ap authProblem) Error() string {
+	return fmt.Sprintf("auth error! message: %s, code: %v", ap.message, ap.code)
 }
 
-handleErr(np)
+type timeoutProblem struct {
+	baseProblem
+}
+
+func (tp timeoutProblem) Error() string {
+	return fmt.Sprintf("timeout error! message: %s, code: %v", tp.message, tp.code)
+}
+
+type validationProblem struct {
+	baseProblem
+}
+
+func (vp validationProblem) Error() string {
+	return fmt.Sprintf("validation error! message: %s, code: %v", vp.message, vp.code)
+}
+
+// Sentinel errors for the common cases, mapped to their usual HTTP-style
+// codes. Wrap them with fmt.Errorf("...: %w", ErrNotFound) to add context
+// while keeping them discoverable via errors.Is.
+var (
+	ErrNotFound     error = networkProblem{baseProblem{message: "not found", code: 404}}
+	ErrUnauthorized error = authProblem{baseProblem{message: "unauthorized", code: 401}}
+	ErrTimeout      error = timeoutProblem{baseProblem{message: "timed out", code: 408}}
+	ErrValidation   error = validationProblem{baseProblem{message: "validation failed", code: 422}}
+)
+
+func handleErr(err error) {
+	fmt.Println(fmt.Errorf("handling error: %w", err).Error())
+}
 
-// prints "network error! message: we received a problem, code: 404"
+// Example usage:
+//
+//	np := networkProblem{baseProblem{message: "we received a problem", code: 404}}
+//	handleErr(np)
+//	// prints "handling error: network error! message: we received a problem, code: 404"
 
 type File interface {
 	io.Closer
 	io.Reader
-	io.Writer	
-	Readdir(count in)([]os.FileInfo, error)
-	Slat() (os.FileInfo, error)
+	io.Writer
+	Readdir(count int) ([]os.FileInfo, error)
+	Stat() (os.FileInfo, error)
 }
 
-type car interface {
+// Car is exported so the vehicles subpackage can provide concrete implementations and a
+// registry around it.
+type Car interface {
 	Color() string
 	Speed() int
 }
 
-type firetruck interface {
-	car
+type Firetruck interface {
+	Car
 	HoseLength() int
 }
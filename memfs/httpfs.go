@@ -0,0 +1,56 @@
+package memfs
+
+import (
+	"io"
+	"net/http"
+	"os"
+
+	localinterface "github.com/alikatgh/CargoForge-C"
+)
+
+// HTTPFileSystem adapts an in-memory tree rooted at root to
+// http.FileSystem, so it can be handed to http.FileServer or wrapped in
+// http.NewFileTransport to serve a file:// RoundTripper, without ever
+// touching the OS disk.
+//
+// root must implement Open(name string) (localinterface.File, error) —
+// as returned by Node.Open — since the minimal File interface has no way
+// to resolve a child by path; the adapter needs that to walk the tree.
+func HTTPFileSystem(root localinterface.File) http.FileSystem {
+	return &httpFS{root: root}
+}
+
+type httpFS struct {
+	root localinterface.File
+}
+
+// opener is satisfied by Node.Open and handle.Open, letting the adapter
+// resolve nested paths without depending on memfs's concrete tree type.
+type opener interface {
+	Open(name string) (localinterface.File, error)
+}
+
+func (fs *httpFS) Open(name string) (http.File, error) {
+	o, ok := fs.root.(opener)
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrInvalid}
+	}
+	target, err := o.Open(name)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+	seeker, ok := target.(io.Seeker)
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrInvalid}
+	}
+	return &httpFile{File: target, Seeker: seeker}, nil
+}
+
+// httpFile adapts a localinterface.File handle to http.File, which in
+// addition to Readdir/Stat requires io.Seeker for range-request support.
+type httpFile struct {
+	localinterface.File
+	io.Seeker
+}
+
+var _ http.File = (*httpFile)(nil)
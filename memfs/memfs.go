@@ -0,0 +1,253 @@
+// Package memfs provides an in-memory tree of localinterface.File values,
+// so callers can mount a testable, dependency-free filesystem into HTTP
+// handlers or CLI tools without touching the OS disk.
+package memfs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	localinterface "github.com/alikatgh/CargoForge-C"
+)
+
+// Node is a single entry in the tree: either a directory holding named
+// children, or a regular file backed by an in-memory byte slice. Node
+// itself only exposes tree structure and metadata (AddChild, Readdir,
+// Stat); call Open to get a localinterface.File handle with its own
+// read/write position.
+type Node struct {
+	mu       sync.RWMutex
+	name     string
+	mode     os.FileMode
+	modTime  time.Time
+	isDir    bool
+	children map[string]*Node
+	data     []byte
+}
+
+// NewDir creates an empty directory node.
+func NewDir(name string) *Node {
+	return &Node{
+		name:     name,
+		mode:     os.ModeDir | 0o755,
+		modTime:  time.Now(),
+		isDir:    true,
+		children: make(map[string]*Node),
+	}
+}
+
+// NewFile creates a regular file node holding a copy of data.
+func NewFile(name string, data []byte) *Node {
+	buf := make([]byte, len(data))
+	copy(buf, data)
+	return &Node{
+		name:    name,
+		mode:    0o644,
+		modTime: time.Now(),
+		data:    buf,
+	}
+}
+
+// AddChild attaches child under the directory n, returning a
+// localinterface.ErrValidation-wrapped error if n isn't a directory or
+// already has an entry with that name.
+func (n *Node) AddChild(child *Node) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.isDir {
+		return fmt.Errorf("memfs: add child %q: %w", child.name, localinterface.ErrValidation)
+	}
+	if _, exists := n.children[child.name]; exists {
+		return fmt.Errorf("memfs: %q already exists: %w", child.name, localinterface.ErrValidation)
+	}
+	n.children[child.name] = child
+	return nil
+}
+
+// lookup resolves a slash-separated path relative to n, walking through
+// directory children. An empty path or "." resolves to n itself.
+func (n *Node) lookup(p string) (*Node, error) {
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == "" || p == "." {
+		return n, nil
+	}
+
+	cur := n
+	for _, part := range strings.Split(p, "/") {
+		cur.mu.RLock()
+		child, ok := cur.children[part]
+		cur.mu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("memfs: %q: %w", p, localinterface.ErrNotFound)
+		}
+		cur = child
+	}
+	return cur, nil
+}
+
+// Open resolves a slash-separated path relative to n and returns a fresh
+// handle onto the node it names. It lets callers that only hold the
+// minimal localinterface.File interface (which has no by-name accessor)
+// descend into a directory tree, by type-asserting to the Opener shape.
+//
+// Open always hands back a new handle rather than the shared *Node:
+// concurrent opens of the same path — e.g. two HTTP requests for the
+// same file — each need their own read/seek position, or they'd
+// interleave and corrupt one another's stream.
+func (n *Node) Open(name string) (localinterface.File, error) {
+	target, err := n.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	return &handle{node: target}, nil
+}
+
+// handle is a per-Open cursor onto a Node's contents, so concurrent
+// Open calls never share a read/write position.
+type handle struct {
+	node *Node
+	pos  int64
+}
+
+func (h *handle) Close() error {
+	h.pos = 0
+	return nil
+}
+
+// Open descends from the node this handle points at, so a handle can
+// also serve as the root passed to HTTPFileSystem/filehttp.Handler.
+func (h *handle) Open(name string) (localinterface.File, error) {
+	return h.node.Open(name)
+}
+
+func (h *handle) Read(p []byte) (int, error) {
+	n := h.node
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	if n.isDir {
+		return 0, fmt.Errorf("memfs: read %q: %w", n.name, localinterface.ErrValidation)
+	}
+	if h.pos >= int64(len(n.data)) {
+		return 0, io.EOF
+	}
+	c := copy(p, n.data[h.pos:])
+	h.pos += int64(c)
+	return c, nil
+}
+
+func (h *handle) Write(p []byte) (int, error) {
+	n := h.node
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.isDir {
+		return 0, fmt.Errorf("memfs: write %q: %w", n.name, localinterface.ErrValidation)
+	}
+	end := h.pos + int64(len(p))
+	if end > int64(len(n.data)) {
+		grown := make([]byte, end)
+		copy(grown, n.data)
+		n.data = grown
+	}
+	c := copy(n.data[h.pos:end], p)
+	h.pos += int64(c)
+	n.modTime = time.Now()
+	return c, nil
+}
+
+// Seek implements io.Seeker so a handle can also be served through
+// net/http, which requires range-request support.
+func (h *handle) Seek(offset int64, whence int) (int64, error) {
+	n := h.node
+	n.mu.RLock()
+	dataLen := int64(len(n.data))
+	n.mu.RUnlock()
+
+	var base int64
+	switch whence {
+	case 0:
+		base = 0
+	case 1:
+		base = h.pos
+	case 2:
+		base = dataLen
+	default:
+		return 0, fmt.Errorf("memfs: seek %q: invalid whence %d", n.name, whence)
+	}
+
+	newPos := base + offset
+	if newPos < 0 {
+		return 0, fmt.Errorf("memfs: seek %q: negative position", n.name)
+	}
+	h.pos = newPos
+	return newPos, nil
+}
+
+func (h *handle) Readdir(count int) ([]os.FileInfo, error) { return h.node.Readdir(count) }
+
+func (h *handle) Stat() (os.FileInfo, error) { return h.node.Stat() }
+
+var _ localinterface.File = (*handle)(nil)
+
+func (n *Node) Readdir(count int) ([]os.FileInfo, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	if !n.isDir {
+		return nil, fmt.Errorf("memfs: readdir %q: %w", n.name, localinterface.ErrValidation)
+	}
+
+	infos := make([]os.FileInfo, 0, len(n.children))
+	for _, child := range n.children {
+		child.mu.RLock()
+		infos = append(infos, child.info())
+		child.mu.RUnlock()
+	}
+	if count > 0 && count < len(infos) {
+		infos = infos[:count]
+	}
+	return infos, nil
+}
+
+func (n *Node) Stat() (os.FileInfo, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.info(), nil
+}
+
+// info builds the os.FileInfo snapshot. Callers must hold n.mu.
+func (n *Node) info() os.FileInfo {
+	size := int64(len(n.data))
+	if n.isDir {
+		size = 0
+	}
+	return fileInfo{
+		name:    n.name,
+		size:    size,
+		mode:    n.mode,
+		modTime: n.modTime,
+		isDir:   n.isDir,
+	}
+}
+
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+	isDir   bool
+}
+
+func (fi fileInfo) Name() string       { return fi.name }
+func (fi fileInfo) Size() int64        { return fi.size }
+func (fi fileInfo) Mode() os.FileMode  { return fi.mode }
+func (fi fileInfo) ModTime() time.Time { return fi.modTime }
+func (fi fileInfo) IsDir() bool        { return fi.isDir }
+func (fi fileInfo) Sys() any           { return nil }
@@ -0,0 +1,167 @@
+// Package vehicles provides concrete implementations of the
+// localinterface.Car/Firetruck interfaces, a functional-options builder
+// for the more involved Firetruck, and a Registry for looking vehicles up
+// by kind at runtime.
+package vehicles
+
+import (
+	"fmt"
+	"sync"
+
+	localinterface "github.com/alikatgh/CargoForge-C"
+)
+
+// Sedan is the plain Car implementation.
+type Sedan struct {
+	color string
+	speed int
+}
+
+func NewSedan(color string, speed int) (*Sedan, error) {
+	if color == "" {
+		return nil, fmt.Errorf("vehicles: sedan color: %w", localinterface.ErrValidation)
+	}
+	if speed <= 0 {
+		return nil, fmt.Errorf("vehicles: sedan speed must be positive: %w", localinterface.ErrValidation)
+	}
+	return &Sedan{color: color, speed: speed}, nil
+}
+
+func (s *Sedan) Color() string { return s.color }
+func (s *Sedan) Speed() int    { return s.speed }
+
+// Ambulance is another plain Car implementation.
+type Ambulance struct {
+	color string
+	speed int
+}
+
+func NewAmbulance(color string, speed int) (*Ambulance, error) {
+	if color == "" {
+		return nil, fmt.Errorf("vehicles: ambulance color: %w", localinterface.ErrValidation)
+	}
+	if speed <= 0 {
+		return nil, fmt.Errorf("vehicles: ambulance speed must be positive: %w", localinterface.ErrValidation)
+	}
+	return &Ambulance{color: color, speed: speed}, nil
+}
+
+func (a *Ambulance) Color() string { return a.color }
+func (a *Ambulance) Speed() int    { return a.speed }
+
+// Firetruck implements localinterface.Firetruck.
+type Firetruck struct {
+	color      string
+	speed      int
+	hoseLength int
+}
+
+func (f *Firetruck) Color() string   { return f.color }
+func (f *Firetruck) Speed() int      { return f.speed }
+func (f *Firetruck) HoseLength() int { return f.hoseLength }
+
+// firetruckConfig accumulates FiretruckOption values before NewFiretruck
+// validates and builds the Firetruck.
+type firetruckConfig struct {
+	color      string
+	speed      int
+	hoseLength int
+}
+
+type FiretruckOption func(*firetruckConfig) error
+
+func WithColor(color string) FiretruckOption {
+	return func(c *firetruckConfig) error {
+		if color == "" {
+			return fmt.Errorf("vehicles: firetruck color: %w", localinterface.ErrValidation)
+		}
+		c.color = color
+		return nil
+	}
+}
+
+func WithSpeed(speed int) FiretruckOption {
+	return func(c *firetruckConfig) error {
+		if speed <= 0 {
+			return fmt.Errorf("vehicles: firetruck speed must be positive: %w", localinterface.ErrValidation)
+		}
+		c.speed = speed
+		return nil
+	}
+}
+
+func WithHoseLength(length int) FiretruckOption {
+	return func(c *firetruckConfig) error {
+		if length <= 0 {
+			return fmt.Errorf("vehicles: firetruck hose length must be positive: %w", localinterface.ErrValidation)
+		}
+		c.hoseLength = length
+		return nil
+	}
+}
+
+// NewFiretruck builds a Firetruck from the given options, defaulting to
+// a red truck doing 60 with a 30m hose. Options are applied in order and
+// the first validation failure is returned.
+func NewFiretruck(opts ...FiretruckOption) (*Firetruck, error) {
+	cfg := firetruckConfig{color: "red", speed: 60, hoseLength: 30}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, err
+		}
+	}
+	return &Firetruck{color: cfg.color, speed: cfg.speed, hoseLength: cfg.hoseLength}, nil
+}
+
+// Factory builds a Car on demand, e.g. from a Registry entry.
+type Factory func() (localinterface.Car, error)
+
+// Registry looks up Car factories by a string kind, so callers can add
+// vehicle kinds at runtime without editing this package.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+// Register adds or replaces the factory for kind.
+func (r *Registry) Register(kind string, factory Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[kind] = factory
+}
+
+// New builds a Car for kind, returning a wrapped ErrNotFound if no
+// factory was registered for it.
+func (r *Registry) New(kind string) (localinterface.Car, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[kind]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("vehicles: unknown kind %q: %w", kind, localinterface.ErrNotFound)
+	}
+	return factory()
+}
+
+// Describe renders a human-readable summary of c. Firetrucks are
+// special-cased by asserting against the localinterface.Firetruck
+// interface rather than a concrete type, the same way errors.As matches
+// against a target type rather than a specific error value — so any Car
+// implementation that also satisfies Firetruck gets the richer
+// description, not just this package's own Firetruck struct.
+func Describe(c localinterface.Car) string {
+	if ft, ok := c.(localinterface.Firetruck); ok {
+		return fmt.Sprintf("firetruck (color=%s, speed=%d, hose=%dm)", ft.Color(), ft.Speed(), ft.HoseLength())
+	}
+	switch v := c.(type) {
+	case *Sedan:
+		return fmt.Sprintf("sedan (color=%s, speed=%d)", v.Color(), v.Speed())
+	case *Ambulance:
+		return fmt.Sprintf("ambulance (color=%s, speed=%d)", v.Color(), v.Speed())
+	default:
+		return fmt.Sprintf("vehicle (color=%s, speed=%d)", c.Color(), c.Speed())
+	}
+}